@@ -0,0 +1,98 @@
+// Package bitbucket implements ghrecon.Source against the Bitbucket Cloud
+// REST v2.0 API.
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+)
+
+const apiBase = "https://api.bitbucket.org/2.0"
+
+// Client is a minimal Bitbucket Cloud REST v2.0 API client authenticated
+// with a bearer token (workspace or repository access token).
+type Client struct {
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient returns a Client authenticated with the given access token.
+func NewClient(token string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		token:      token,
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, rawURL string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not build request for %s: %w", rawURL, err)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return req, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, rawURL string, out interface{}) error {
+	req, err := c.newRequest(ctx, rawURL)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not fetch %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s for %s", resp.Status, rawURL)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response from %s: %w", rawURL, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("could not parse json from %s: %w", rawURL, err)
+	}
+
+	return nil
+}
+
+// pagedResponse is the envelope every Bitbucket v2.0 list endpoint wraps
+// its results in. Next carries the fully-formed URL of the following
+// page, or "" on the last page.
+type pagedResponse struct {
+	Values json.RawMessage `json:"values"`
+	Next   string          `json:"next"`
+}
+
+// paginate walks every page reachable from rawURL via the response body's
+// "next" field, handing each page's raw "values" array to decodePage.
+func (c *Client) paginate(ctx context.Context, rawURL string, decodePage func(values json.RawMessage) error) error {
+	next := rawURL
+
+	for next != "" {
+		var page pagedResponse
+		if err := c.getJSON(ctx, next, &page); err != nil {
+			return err
+		}
+
+		if err := decodePage(page.Values); err != nil {
+			return err
+		}
+
+		next = page.Next
+	}
+
+	return nil
+}
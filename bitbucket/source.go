@@ -0,0 +1,136 @@
+package bitbucket
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghrecon "github.com/m1dugh/gh-recon"
+)
+
+type workspace struct {
+	Uuid string `json:"uuid"`
+	Slug string `json:"slug"`
+}
+
+type repo struct {
+	Name      string `json:"name"`
+	FullName  string `json:"full_name"`
+	IsPrivate bool   `json:"is_private"`
+	Links     struct {
+		Clone []struct {
+			Name string `json:"name"`
+			Href string `json:"href"`
+		} `json:"clone"`
+	} `json:"links"`
+	Owner struct {
+		Nickname string `json:"nickname"`
+		Type     string `json:"type"` // "team" or "user"
+	} `json:"owner"`
+	Parent *struct {
+		FullName string `json:"full_name"`
+	} `json:"parent"`
+}
+
+type member struct {
+	User struct {
+		Nickname string `json:"nickname"`
+		Uuid     string `json:"uuid"`
+	} `json:"user"`
+}
+
+// Source is a ghrecon.Source backed by the Bitbucket Cloud REST v2.0 API.
+type Source struct {
+	client *Client
+}
+
+// NewSource returns a Source authenticated with the given workspace or
+// repository access token.
+func NewSource(token string) *Source {
+	return &Source{client: NewClient(token)}
+}
+
+// Organization resolves the Bitbucket workspace named name.
+func (s *Source) Organization(ctx context.Context, name string) (*ghrecon.Organization, error) {
+	var w workspace
+	if err := s.client.getJSON(ctx, fmt.Sprintf("%s/workspaces/%s", apiBase, name), &w); err != nil {
+		return nil, fmt.Errorf("could not get workspace %s: %w", name, err)
+	}
+
+	return &ghrecon.Organization{Login: w.Slug}, nil
+}
+
+// Repositories returns every repository in the workspace named owner.
+// Bitbucket has no per-user repository listing equivalent to GitHub's, so
+// owner is always treated as a workspace slug.
+func (s *Source) Repositories(ctx context.Context, owner string) ([]ghrecon.Repository, error) {
+	var repos []repo
+
+	err := s.client.paginate(ctx, fmt.Sprintf("%s/repositories/%s", apiBase, owner), func(values json.RawMessage) error {
+		var page []repo
+		if err := json.Unmarshal(values, &page); err != nil {
+			return fmt.Errorf("could not parse json page of repos: %w", err)
+		}
+		repos = append(repos, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get repositories of %s: %w", owner, err)
+	}
+
+	converted := make([]ghrecon.Repository, len(repos))
+	for i, r := range repos {
+		converted[i] = toRepository(r)
+	}
+	return converted, nil
+}
+
+// Members returns every member of the workspace named org.
+func (s *Source) Members(ctx context.Context, org string) ([]ghrecon.User, error) {
+	var members []member
+
+	err := s.client.paginate(ctx, fmt.Sprintf("%s/workspaces/%s/members", apiBase, org), func(values json.RawMessage) error {
+		var page []member
+		if err := json.Unmarshal(values, &page); err != nil {
+			return fmt.Errorf("could not parse json page of members: %w", err)
+		}
+		members = append(members, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get members of %s: %w", org, err)
+	}
+
+	users := make([]ghrecon.User, len(members))
+	for i, m := range members {
+		users[i] = ghrecon.User{Login: m.User.Nickname, Type: "User"}
+	}
+	return users, nil
+}
+
+func toRepository(r repo) ghrecon.Repository {
+	var cloneURL string
+	for _, clone := range r.Links.Clone {
+		if clone.Name == "https" {
+			cloneURL = clone.Href
+			break
+		}
+	}
+
+	ownerType := "User"
+	if r.Owner.Type == "team" {
+		ownerType = "Organization"
+	}
+
+	return ghrecon.Repository{
+		Name:     r.Name,
+		FullName: r.FullName,
+		Url:      cloneURL,
+		Private:  r.IsPrivate,
+		Fork:     r.Parent != nil,
+		Owner: ghrecon.User{
+			Login: r.Owner.Nickname,
+			Type:  ownerType,
+		},
+	}
+}
@@ -0,0 +1,132 @@
+package bitbucket
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	ghrecon "github.com/m1dugh/gh-recon"
+)
+
+// roundTripFunc lets a plain function satisfy http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// redirectTo rewires every request to target's host, so tests can exercise
+// Source against the hardcoded apiBase while actually talking to an
+// httptest server.
+func redirectTo(target *httptest.Server) http.RoundTripper {
+	base, err := url.Parse(target.URL)
+	if err != nil {
+		panic(err)
+	}
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = base.Scheme
+		req.URL.Host = base.Host
+		return http.DefaultTransport.RoundTrip(req)
+	})
+}
+
+func newTestServer(t *testing.T) (*httptest.Server, *http.ServeMux, *Source) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+
+	source := NewSource("")
+	source.client.httpClient.Transport = redirectTo(server)
+
+	return server, mux, source
+}
+
+func TestSourceOrganization(t *testing.T) {
+	_, mux, source := newTestServer(t)
+
+	mux.HandleFunc("/2.0/workspaces/acme", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"uuid":"{123}","slug":"acme"}`)
+	})
+
+	org, err := source.Organization(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Organization() error: %v", err)
+	}
+	if org.Login != "acme" {
+		t.Errorf("Organization() = %+v, want Login=acme", org)
+	}
+}
+
+func TestSourceRepositoriesWalksPages(t *testing.T) {
+	_, mux, source := newTestServer(t)
+
+	mux.HandleFunc("/2.0/repositories/acme", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"values":[{"name":"infra","full_name":"acme/infra","is_private":true,"links":{"clone":[{"name":"https","href":"https://bitbucket.org/acme/infra.git"}]},"owner":{"nickname":"acme","type":"team"}}],"next":"http://%s/2.0/repositories/acme/page2"}`, r.Host)
+	})
+	mux.HandleFunc("/2.0/repositories/acme/page2", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"values":[{"name":"dotfiles","full_name":"acme/dotfiles","is_private":false,"links":{"clone":[{"name":"https","href":"https://bitbucket.org/acme/dotfiles.git"}]},"owner":{"nickname":"acme","type":"team"},"parent":{"full_name":"jdoe/dotfiles"}}],"next":""}`)
+	})
+
+	repos, err := source.Repositories(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Repositories() error: %v", err)
+	}
+	if len(repos) != 2 {
+		t.Fatalf("len(Repositories()) = %d, want 2", len(repos))
+	}
+	if repos[0].FullName != "acme/infra" || !repos[0].Private || repos[0].Owner.Type != "Organization" {
+		t.Errorf("Repositories()[0] = %+v, want Private=true Owner.Type=Organization", repos[0])
+	}
+	if repos[1].FullName != "acme/dotfiles" || repos[1].Private || !repos[1].Fork {
+		t.Errorf("Repositories()[1] = %+v, want Private=false Fork=true", repos[1])
+	}
+}
+
+func TestSourceMembers(t *testing.T) {
+	_, mux, source := newTestServer(t)
+
+	mux.HandleFunc("/2.0/workspaces/acme/members", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"values":[{"user":{"nickname":"alice","uuid":"{1}"}}],"next":""}`)
+	})
+
+	members, err := source.Members(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Members() error: %v", err)
+	}
+	if len(members) != 1 || members[0].Login != "alice" || members[0].Type != "User" {
+		t.Fatalf("Members() = %+v, want one User alice", members)
+	}
+}
+
+func TestToRepository(t *testing.T) {
+	r := repo{
+		Name:      "infra",
+		FullName:  "acme/infra",
+		IsPrivate: true,
+	}
+	r.Links.Clone = []struct {
+		Name string `json:"name"`
+		Href string `json:"href"`
+	}{
+		{Name: "ssh", Href: "git@bitbucket.org:acme/infra.git"},
+		{Name: "https", Href: "https://bitbucket.org/acme/infra.git"},
+	}
+	r.Owner.Nickname = "acme"
+	r.Owner.Type = "team"
+
+	got := toRepository(r)
+	want := ghrecon.Repository{
+		Name:     "infra",
+		FullName: "acme/infra",
+		Url:      "https://bitbucket.org/acme/infra.git",
+		Private:  true,
+		Fork:     false,
+		Owner:    ghrecon.User{Login: "acme", Type: "Organization"},
+	}
+	if got != want {
+		t.Errorf("toRepository() = %+v, want %+v", got, want)
+	}
+}
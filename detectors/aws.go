@@ -0,0 +1,160 @@
+package detectors
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var (
+	awsAccessKeyPattern = regexp.MustCompile(`AKIA[0-9A-Z]{16}`)
+	awsSecretKeyPattern = regexp.MustCompile(`[A-Za-z0-9/+=]{40}`)
+)
+
+// AWSDetector finds AWS access key IDs, pairing each with the nearest
+// 40-character secret key candidate in the same blob so it can be
+// verified live.
+type AWSDetector struct{}
+
+func (d *AWSDetector) Keywords() []string {
+	return []string{"AKIA"}
+}
+
+func (d *AWSDetector) FromData(ctx context.Context, verify bool, data []byte) ([]Finding, error) {
+	keyIdLocs := awsAccessKeyPattern.FindAllIndex(data, -1)
+	if len(keyIdLocs) == 0 {
+		return nil, nil
+	}
+
+	secretLocs := awsSecretKeyPattern.FindAllIndex(data, -1)
+
+	var findings []Finding
+	for _, keyLoc := range keyIdLocs {
+		accessKeyId := string(data[keyLoc[0]:keyLoc[1]])
+		secret := nearestSecret(data, keyLoc[0], secretLocs)
+
+		finding := Finding{
+			RuleName: "aws-access-key",
+			Raw:      accessKeyId,
+			Redacted: redact(accessKeyId),
+			Line:     lineOf(data, keyLoc[0]),
+		}
+
+		if verify && secret != "" {
+			live, err := verifyAWSCredentials(ctx, accessKeyId, secret)
+			if err == nil {
+				finding.Verified = live
+			}
+		}
+
+		findings = append(findings, finding)
+	}
+
+	return findings, nil
+}
+
+// nearestSecret returns the secret-key-shaped match closest to offset, on
+// the assumption that access key and secret key are declared near each
+// other (env file, config block, etc).
+func nearestSecret(data []byte, offset int, secretLocs [][]int) string {
+	best := -1
+	bestDist := -1
+
+	for i, loc := range secretLocs {
+		dist := loc[0] - offset
+		if dist < 0 {
+			dist = -dist
+		}
+		if bestDist == -1 || dist < bestDist {
+			best = i
+			bestDist = dist
+		}
+	}
+
+	if best == -1 {
+		return ""
+	}
+	return string(data[secretLocs[best][0]:secretLocs[best][1]])
+}
+
+// verifyAWSCredentials confirms an access key / secret key pair is live by
+// signing a minimal sts:GetCallerIdentity request with AWS SigV4 and
+// checking for a 200 response.
+func verifyAWSCredentials(ctx context.Context, accessKeyId, secretAccessKey string) (bool, error) {
+	const (
+		region  = "us-east-1"
+		service = "sts"
+		host    = "sts.amazonaws.com"
+	)
+
+	now := time.Now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	dateStamp := now.Format("20060102")
+
+	payload := "Action=GetCallerIdentity&Version=2011-06-15"
+	canonicalHeaders := fmt.Sprintf("host:%s\nx-amz-date:%s\n", host, amzDate)
+	signedHeaders := "host;x-amz-date"
+
+	canonicalRequest := strings.Join([]string{
+		http.MethodPost,
+		"/",
+		"",
+		canonicalHeaders,
+		signedHeaders,
+		sha256Hex([]byte(payload)),
+	}, "\n")
+
+	credentialScope := fmt.Sprintf("%s/%s/%s/aws4_request", dateStamp, region, service)
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		credentialScope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := sigv4SigningKey(secretAccessKey, dateStamp, region, service)
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	authHeader := fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		accessKeyId, credentialScope, signedHeaders, signature)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://"+host+"/", strings.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("Authorization", authHeader)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	return resp.StatusCode == http.StatusOK, nil
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+func sigv4SigningKey(secret, dateStamp, region, service string) []byte {
+	kDate := hmacSHA256([]byte("AWS4"+secret), dateStamp)
+	kRegion := hmacSHA256(kDate, region)
+	kService := hmacSHA256(kRegion, service)
+	return hmacSHA256(kService, "aws4_request")
+}
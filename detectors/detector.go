@@ -0,0 +1,66 @@
+// Package detectors implements secret-scanning rules applied to the raw
+// contents of a TargetFile, modeled on trufflehog's keyword-prefiltered
+// detector pipeline.
+package detectors
+
+import "context"
+
+// Finding is a single potential secret discovered by a Detector. Repo,
+// CommitHash, Path, and Line are filled in by the caller driving the
+// pipeline, not by the Detector itself, since a Detector only ever sees
+// raw file data.
+type Finding struct {
+	RuleName   string
+	Raw        string
+	Redacted   string
+	Verified   bool
+	Repo       string
+	CommitHash string
+	Path       string
+	Line       int
+}
+
+// Detector looks for one kind of secret in a blob of file data.
+type Detector interface {
+	// Keywords returns substrings that must appear in data (checked
+	// case-insensitively) before FromData is worth calling. This lets the
+	// pipeline skip the expensive regex/entropy pass on files that
+	// obviously don't contain a match.
+	Keywords() []string
+
+	// FromData scans data for matches. When verify is true, a detector
+	// able to confirm a match is live (e.g. an AWS key via
+	// sts:GetCallerIdentity) should do so and set Finding.Verified.
+	FromData(ctx context.Context, verify bool, data []byte) ([]Finding, error)
+}
+
+// Default returns one instance of every built-in detector.
+func Default() []Detector {
+	return []Detector{
+		&AWSDetector{},
+		&GCPDetector{},
+		&SlackDetector{},
+		&PrivateKeyDetector{},
+		&EntropyDetector{},
+	}
+}
+
+// lineOf returns the 1-indexed line number at which offset occurs in data.
+func lineOf(data []byte, offset int) int {
+	line := 1
+	for i := 0; i < offset && i < len(data); i++ {
+		if data[i] == '\n' {
+			line++
+		}
+	}
+	return line
+}
+
+// redact keeps a short prefix/suffix of secret and masks the middle, so
+// findings can be logged or displayed without leaking the full value.
+func redact(secret string) string {
+	if len(secret) <= 8 {
+		return "****"
+	}
+	return secret[:4] + "..." + secret[len(secret)-4:]
+}
@@ -0,0 +1,67 @@
+package detectors
+
+import (
+	"context"
+	"math"
+	"regexp"
+)
+
+const entropyThreshold = 4.5
+
+// candidateToken matches runs of base64 or hex alphabet characters at
+// least 20 bytes long, the shape most generic secrets (API keys, tokens)
+// take.
+var candidateToken = regexp.MustCompile(`[A-Za-z0-9+/=_-]{20,}`)
+
+// EntropyDetector flags high-entropy tokens that don't match any of the
+// more specific rule-based detectors. It has no keyword prefilter since,
+// by definition, a generic secret carries no distinguishing keyword.
+type EntropyDetector struct{}
+
+func (d *EntropyDetector) Keywords() []string {
+	return nil
+}
+
+func (d *EntropyDetector) FromData(ctx context.Context, verify bool, data []byte) ([]Finding, error) {
+	var findings []Finding
+
+	for _, loc := range candidateToken.FindAllIndex(data, -1) {
+		token := string(data[loc[0]:loc[1]])
+		if shannonEntropy(token) <= entropyThreshold {
+			continue
+		}
+
+		findings = append(findings, Finding{
+			RuleName: "generic-high-entropy",
+			Raw:      token,
+			Redacted: redact(token),
+			Line:     lineOf(data, loc[0]),
+		})
+	}
+
+	return findings, nil
+}
+
+// shannonEntropy computes the Shannon entropy, in bits per character, of s.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	var counts [256]int
+	for i := 0; i < len(s); i++ {
+		counts[s[i]]++
+	}
+
+	length := float64(len(s))
+	var entropy float64
+	for _, count := range counts {
+		if count == 0 {
+			continue
+		}
+		p := float64(count) / length
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy
+}
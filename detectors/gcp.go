@@ -0,0 +1,44 @@
+package detectors
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+)
+
+// gcpServiceAccountKey mirrors the fields of a GCP service account JSON
+// key that are useful for reporting a finding, ignoring the rest.
+type gcpServiceAccountKey struct {
+	Type        string `json:"type"`
+	ProjectId   string `json:"project_id"`
+	PrivateKey  string `json:"private_key"`
+	ClientEmail string `json:"client_email"`
+}
+
+// GCPDetector finds GCP service account JSON keys.
+type GCPDetector struct{}
+
+func (d *GCPDetector) Keywords() []string {
+	return []string{"service_account", "private_key"}
+}
+
+func (d *GCPDetector) FromData(ctx context.Context, verify bool, data []byte) ([]Finding, error) {
+	if !strings.Contains(string(data), `"service_account"`) {
+		return nil, nil
+	}
+
+	var key gcpServiceAccountKey
+	if err := json.Unmarshal(data, &key); err != nil {
+		return nil, nil
+	}
+
+	if key.Type != "service_account" || key.PrivateKey == "" {
+		return nil, nil
+	}
+
+	return []Finding{{
+		RuleName: "gcp-service-account-key",
+		Raw:      key.PrivateKey,
+		Redacted: redact(key.PrivateKey) + " (" + key.ClientEmail + ")",
+	}}, nil
+}
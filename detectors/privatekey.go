@@ -0,0 +1,34 @@
+package detectors
+
+import (
+	"context"
+	"regexp"
+)
+
+// privateKeyBlock matches a full PEM private key block, from its BEGIN
+// header (e.g. "RSA PRIVATE KEY", "OPENSSH PRIVATE KEY") to its END
+// footer.
+var privateKeyBlock = regexp.MustCompile(`(?s)-----BEGIN [A-Z ]+PRIVATE KEY-----.*?-----END [A-Z ]+PRIVATE KEY-----`)
+
+// PrivateKeyDetector finds PEM-encoded private key blocks.
+type PrivateKeyDetector struct{}
+
+func (d *PrivateKeyDetector) Keywords() []string {
+	return []string{"PRIVATE KEY"}
+}
+
+func (d *PrivateKeyDetector) FromData(ctx context.Context, verify bool, data []byte) ([]Finding, error) {
+	var findings []Finding
+
+	for _, loc := range privateKeyBlock.FindAllIndex(data, -1) {
+		block := string(data[loc[0]:loc[1]])
+		findings = append(findings, Finding{
+			RuleName: "private-key",
+			Raw:      block,
+			Redacted: "<redacted PEM block>",
+			Line:     lineOf(data, loc[0]),
+		})
+	}
+
+	return findings, nil
+}
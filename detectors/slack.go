@@ -0,0 +1,32 @@
+package detectors
+
+import (
+	"context"
+	"regexp"
+)
+
+var slackTokenPattern = regexp.MustCompile(`xox[baprs]-[0-9A-Za-z-]{10,48}`)
+
+// SlackDetector finds Slack API tokens (bot, app, legacy, refresh, and
+// config tokens all share the xox<letter>- prefix).
+type SlackDetector struct{}
+
+func (d *SlackDetector) Keywords() []string {
+	return []string{"xoxb-", "xoxa-", "xoxp-", "xoxr-", "xoxs-"}
+}
+
+func (d *SlackDetector) FromData(ctx context.Context, verify bool, data []byte) ([]Finding, error) {
+	var findings []Finding
+
+	for _, loc := range slackTokenPattern.FindAllIndex(data, -1) {
+		token := string(data[loc[0]:loc[1]])
+		findings = append(findings, Finding{
+			RuleName: "slack-token",
+			Raw:      token,
+			Redacted: redact(token),
+			Line:     lineOf(data, loc[0]),
+		})
+	}
+
+	return findings, nil
+}
@@ -0,0 +1,118 @@
+// Package gitea implements ghrecon.Source against the Gitea REST v1 API.
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const pageSize = 50
+
+// Client is a minimal Gitea REST v1 API client authenticated via a
+// personal access token.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+// NewClient returns a Client against baseURL (a Gitea instance),
+// authenticated with a personal access token.
+func NewClient(baseURL, token string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		token:      token,
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, path string) (*http.Request, error) {
+	rawURL := c.baseURL + "/api/v1" + path
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not build request for %s: %w", rawURL, err)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "token "+c.token)
+	}
+
+	return req, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, out interface{}) error {
+	req, err := c.newRequest(ctx, path)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not fetch %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s for %s", resp.Status, path)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response from %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("could not parse json from %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// paginate walks path page by page via Gitea's page/limit query params,
+// handing each page's raw body to decodePage and stopping once a page
+// comes back with fewer than pageSize items.
+func (c *Client) paginate(ctx context.Context, path string, decodePage func(body []byte) (count int, err error)) error {
+	sep := "?"
+	if strings.Contains(path, "?") {
+		sep = "&"
+	}
+
+	for page := 1; ; page++ {
+		pagedPath := fmt.Sprintf("%s%spage=%d&limit=%d", path, sep, page, pageSize)
+
+		req, err := c.newRequest(ctx, pagedPath)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("could not fetch %s: %w", pagedPath, err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		status := resp.StatusCode
+		resp.Body.Close()
+
+		if status != http.StatusOK {
+			return fmt.Errorf("unexpected status %d for %s", status, pagedPath)
+		}
+		if err != nil {
+			return fmt.Errorf("could not read response from %s: %w", pagedPath, err)
+		}
+
+		count, err := decodePage(body)
+		if err != nil {
+			return err
+		}
+
+		if count < pageSize {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,131 @@
+package gitea
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghrecon "github.com/m1dugh/gh-recon"
+)
+
+type organization struct {
+	Id       int    `json:"id"`
+	UserName string `json:"username"`
+}
+
+type repository struct {
+	Id       int    `json:"id"`
+	Name     string `json:"name"`
+	FullName string `json:"full_name"`
+	CloneUrl string `json:"clone_url"`
+	Private  bool   `json:"private"`
+	Fork     bool   `json:"fork"`
+	Archived bool   `json:"archived"`
+	Owner    struct {
+		Login string `json:"login"`
+		Type  string `json:"type"` // "user" or "organization"
+	} `json:"owner"`
+}
+
+type user struct {
+	Id    int    `json:"id"`
+	Login string `json:"login"`
+}
+
+// Source is a ghrecon.Source backed by the Gitea REST v1 API.
+type Source struct {
+	client *Client
+}
+
+// NewSource returns a Source against baseURL (a Gitea instance),
+// authenticated with a personal access token.
+func NewSource(baseURL, token string) *Source {
+	return &Source{client: NewClient(baseURL, token)}
+}
+
+// Organization resolves the Gitea organization named name.
+func (s *Source) Organization(ctx context.Context, name string) (*ghrecon.Organization, error) {
+	var org organization
+	if err := s.client.getJSON(ctx, fmt.Sprintf("/orgs/%s", name), &org); err != nil {
+		return nil, fmt.Errorf("could not get organization %s: %w", name, err)
+	}
+
+	return &ghrecon.Organization{Id: org.Id, Login: org.UserName}, nil
+}
+
+// Repositories returns every repository owned by owner. owner is first
+// tried as an organization, then falls back to a user's personal repos.
+func (s *Source) Repositories(ctx context.Context, owner string) ([]ghrecon.Repository, error) {
+	repos, err := s.repos(ctx, fmt.Sprintf("/orgs/%s/repos", owner))
+	if err == nil {
+		return repos, nil
+	}
+
+	repos, err = s.repos(ctx, fmt.Sprintf("/users/%s/repos", owner))
+	if err != nil {
+		return nil, fmt.Errorf("could not get repositories of %s: %w", owner, err)
+	}
+	return repos, nil
+}
+
+// Members returns every member of the organization named org.
+func (s *Source) Members(ctx context.Context, org string) ([]ghrecon.User, error) {
+	var members []user
+	err := s.client.paginate(ctx, fmt.Sprintf("/orgs/%s/members", org), func(body []byte) (int, error) {
+		var page []user
+		if err := json.Unmarshal(body, &page); err != nil {
+			return 0, fmt.Errorf("could not parse json page of members: %w", err)
+		}
+		members = append(members, page...)
+		return len(page), nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get members of %s: %w", org, err)
+	}
+
+	users := make([]ghrecon.User, len(members))
+	for i, m := range members {
+		users[i] = ghrecon.User{Id: m.Id, Login: m.Login, Type: "User"}
+	}
+	return users, nil
+}
+
+func (s *Source) repos(ctx context.Context, path string) ([]ghrecon.Repository, error) {
+	var repos []repository
+	err := s.client.paginate(ctx, path, func(body []byte) (int, error) {
+		var page []repository
+		if err := json.Unmarshal(body, &page); err != nil {
+			return 0, fmt.Errorf("could not parse json page of repos: %w", err)
+		}
+		repos = append(repos, page...)
+		return len(page), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	converted := make([]ghrecon.Repository, len(repos))
+	for i, r := range repos {
+		converted[i] = ghrecon.Repository{
+			Id:       r.Id,
+			Name:     r.Name,
+			FullName: r.FullName,
+			Url:      r.CloneUrl,
+			Private:  r.Private,
+			Fork:     r.Fork,
+			Archived: r.Archived,
+			Owner: ghrecon.User{
+				Login: r.Owner.Login,
+				Type:  ownerType(r.Owner.Type),
+			},
+		}
+	}
+	return converted, nil
+}
+
+func ownerType(kind string) string {
+	if kind == "organization" {
+		return "Organization"
+	}
+	return "User"
+}
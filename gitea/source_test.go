@@ -0,0 +1,93 @@
+package gitea
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"context"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *http.ServeMux) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, mux
+}
+
+func TestSourceOrganization(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v1/orgs/acme", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"username":"acme"}`)
+	})
+
+	source := NewSource(server.URL, "")
+	org, err := source.Organization(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Organization() error: %v", err)
+	}
+	if org.Login != "acme" || org.Id != 1 {
+		t.Errorf("Organization() = %+v, want Login=acme Id=1", org)
+	}
+}
+
+func TestSourceRepositoriesFallsBackToUserRepos(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v1/orgs/jdoe/repos", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/v1/users/jdoe/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":2,"name":"dotfiles","full_name":"jdoe/dotfiles","clone_url":"https://gitea.example.com/jdoe/dotfiles.git","private":false,"owner":{"login":"jdoe","type":"user"}}]`)
+	})
+
+	source := NewSource(server.URL, "")
+	repos, err := source.Repositories(context.Background(), "jdoe")
+	if err != nil {
+		t.Fatalf("Repositories() error: %v", err)
+	}
+	if len(repos) != 1 || repos[0].FullName != "jdoe/dotfiles" || repos[0].Owner.Type != "User" {
+		t.Fatalf("Repositories() = %+v, want one jdoe/dotfiles User repo", repos)
+	}
+}
+
+func TestSourceRepositoriesOrganizationOwner(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v1/orgs/acme/repos", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":3,"name":"infra","full_name":"acme/infra","clone_url":"https://gitea.example.com/acme/infra.git","private":true,"archived":true,"owner":{"login":"acme","type":"organization"}}]`)
+	})
+
+	source := NewSource(server.URL, "")
+	repos, err := source.Repositories(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Repositories() error: %v", err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("len(Repositories()) = %d, want 1", len(repos))
+	}
+	repo := repos[0]
+	if !repo.Private || !repo.Archived || repo.Owner.Type != "Organization" {
+		t.Errorf("Repositories()[0] = %+v, want Private=true Archived=true Owner.Type=Organization", repo)
+	}
+}
+
+func TestSourceMembers(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v1/orgs/acme/members", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":5,"login":"alice"}]`)
+	})
+
+	source := NewSource(server.URL, "")
+	members, err := source.Members(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Members() error: %v", err)
+	}
+	if len(members) != 1 || members[0].Login != "alice" || members[0].Type != "User" {
+		t.Fatalf("Members() = %+v, want one User alice", members)
+	}
+}
@@ -0,0 +1,241 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultPageSize is passed as per_page on every paginated request.
+	// GitHub defaults to 30 per page, which silently truncates results
+	// for anything but the smallest orgs.
+	defaultPageSize = 100
+	maxRetries      = 5
+	initialBackoff  = 500 * time.Millisecond
+)
+
+// Client is an authenticated GitHub API client. It handles bearer-token
+// auth, transparent pagination via the Link header, and backs off instead
+// of failing on rate limits or transient 5xx responses.
+type Client struct {
+	httpClient *http.Client
+	token      string
+}
+
+// NewClient returns a Client authenticated with the given personal access
+// token or GitHub App installation token. An empty token yields an
+// unauthenticated client, subject to GitHub's much lower rate limits.
+func NewClient(token string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		token:      token,
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, rawURL string) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not build request for %s: %w", rawURL, err)
+	}
+
+	req.Header.Set("Accept", "application/vnd.github+json")
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	return req, nil
+}
+
+// do executes req, retrying transient 5xx responses with exponential
+// backoff and sleeping out primary/secondary rate limits instead of
+// surfacing a 403/429 to the caller.
+func (c *Client) do(req *http.Request) (*http.Response, error) {
+	var lastErr error
+	backoff := initialBackoff
+
+	for attempt := 0; attempt <= maxRetries; attempt++ {
+		if attempt > 0 {
+			if err := sleep(req.Context(), backoff); err != nil {
+				return nil, err
+			}
+			backoff *= 2
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if resp.StatusCode == http.StatusForbidden || resp.StatusCode == http.StatusTooManyRequests {
+			if wait, ok := rateLimitWait(resp); ok {
+				resp.Body.Close()
+				if err := sleep(req.Context(), wait); err != nil {
+					return nil, err
+				}
+				continue
+			}
+		}
+
+		if resp.StatusCode >= http.StatusInternalServerError {
+			resp.Body.Close()
+			lastErr = fmt.Errorf("github returned %s", resp.Status)
+			continue
+		}
+
+		return resp, nil
+	}
+
+	return nil, fmt.Errorf("giving up on %s after %d attempts: %w", req.URL, maxRetries+1, lastErr)
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// rateLimitWait reports how long to sleep before retrying a rate-limited
+// response, preferring Retry-After (secondary/abuse limit) and falling
+// back to X-RateLimit-Reset (primary limit).
+func rateLimitWait(resp *http.Response) (time.Duration, bool) {
+	if retryAfter := resp.Header.Get("Retry-After"); retryAfter != "" {
+		if secs, err := strconv.Atoi(retryAfter); err == nil {
+			return time.Duration(secs) * time.Second, true
+		}
+	}
+
+	if resp.Header.Get("X-RateLimit-Remaining") != "0" {
+		return 0, false
+	}
+
+	reset := resp.Header.Get("X-RateLimit-Reset")
+	if reset == "" {
+		return 0, false
+	}
+
+	epoch, err := strconv.ParseInt(reset, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+
+	wait := time.Until(time.Unix(epoch, 0))
+	if wait < 0 {
+		wait = 0
+	}
+	return wait + time.Second, true
+}
+
+// nextPageURL extracts the "next" relation from a GitHub Link header, or
+// "" once the last page has been reached.
+func nextPageURL(resp *http.Response) string {
+	for _, part := range strings.Split(resp.Header.Get("Link"), ",") {
+		segments := strings.Split(strings.TrimSpace(part), ";")
+		if len(segments) < 2 || strings.TrimSpace(segments[1]) != `rel="next"` {
+			continue
+		}
+		return strings.Trim(strings.TrimSpace(segments[0]), "<>")
+	}
+	return ""
+}
+
+// withPageSize sets per_page on rawURL unless the caller already specified
+// one.
+func withPageSize(rawURL string, perPage int) (string, error) {
+	parsed, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid url %q: %w", rawURL, err)
+	}
+
+	query := parsed.Query()
+	if query.Get("per_page") == "" {
+		query.Set("per_page", strconv.Itoa(perPage))
+	}
+	parsed.RawQuery = query.Encode()
+
+	return parsed.String(), nil
+}
+
+// getJSON fetches rawURL and decodes the response body into out.
+func (c *Client) getJSON(ctx context.Context, rawURL string, out interface{}) error {
+	req, err := c.newRequest(ctx, rawURL)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.do(req)
+	if err != nil {
+		return fmt.Errorf("could not fetch %s: %w", rawURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s for %s", resp.Status, rawURL)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response from %s: %w", rawURL, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("could not parse json from %s: %w", rawURL, err)
+	}
+
+	return nil
+}
+
+// paginate walks every page reachable from rawURL via the Link: rel="next"
+// header, handing each page's raw body to decodePage until there is no
+// next page left.
+func (c *Client) paginate(ctx context.Context, rawURL string, decodePage func(body []byte) error) error {
+	next, err := withPageSize(rawURL, defaultPageSize)
+	if err != nil {
+		return err
+	}
+
+	for next != "" {
+		req, err := c.newRequest(ctx, next)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.do(req)
+		if err != nil {
+			return fmt.Errorf("could not fetch %s: %w", next, err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		following := nextPageURL(resp)
+		status := resp.StatusCode
+		resp.Body.Close()
+
+		if status != http.StatusOK {
+			return fmt.Errorf("unexpected status %d for %s", status, next)
+		}
+		if err != nil {
+			return fmt.Errorf("could not read response from %s: %w", next, err)
+		}
+
+		if err := decodePage(body); err != nil {
+			return err
+		}
+
+		next = following
+	}
+
+	return nil
+}
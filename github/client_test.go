@@ -0,0 +1,185 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestWithPageSize(t *testing.T) {
+	tests := []struct {
+		name    string
+		rawURL  string
+		perPage int
+		want    string
+	}{
+		{"adds per_page when absent", "https://api.github.com/orgs/acme/repos", 100, "https://api.github.com/orgs/acme/repos?per_page=100"},
+		{"leaves caller-specified per_page alone", "https://api.github.com/orgs/acme/repos?per_page=10", 100, "https://api.github.com/orgs/acme/repos?per_page=10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := withPageSize(tt.rawURL, tt.perPage)
+			if err != nil {
+				t.Fatalf("withPageSize() error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("withPageSize() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNextPageURL(t *testing.T) {
+	tests := []struct {
+		name string
+		link string
+		want string
+	}{
+		{"no link header", "", ""},
+		{"single next relation", `<https://api.github.com/orgs/acme/repos?page=2>; rel="next"`, "https://api.github.com/orgs/acme/repos?page=2"},
+		{
+			"next among multiple relations",
+			`<https://api.github.com/orgs/acme/repos?page=1>; rel="prev", <https://api.github.com/orgs/acme/repos?page=3>; rel="next", <https://api.github.com/orgs/acme/repos?page=5>; rel="last"`,
+			"https://api.github.com/orgs/acme/repos?page=3",
+		},
+		{"only a last relation, no next", `<https://api.github.com/orgs/acme/repos?page=5>; rel="last"`, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &http.Response{Header: http.Header{"Link": {tt.link}}}
+			if got := nextPageURL(resp); got != tt.want {
+				t.Errorf("nextPageURL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClientPaginateWalksEveryPage(t *testing.T) {
+	pages := [][]byte{
+		[]byte(`[1,2]`),
+		[]byte(`[3,4]`),
+		[]byte(`[5]`),
+	}
+
+	var requestedPerPage []string
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestedPerPage = append(requestedPerPage, r.URL.Query().Get("per_page"))
+
+		page := 0
+		if p := r.URL.Query().Get("page"); p != "" {
+			fmt.Sscanf(p, "%d", &page)
+		}
+
+		if page+1 < len(pages) {
+			nextURL := fmt.Sprintf("http://%s%s?per_page=%s&page=%d", r.Host, r.URL.Path, r.URL.Query().Get("per_page"), page+1)
+			w.Header().Set("Link", fmt.Sprintf(`<%s>; rel="next"`, nextURL))
+		}
+		w.Write(pages[page])
+	}))
+	defer server.Close()
+
+	client := NewClient("")
+
+	var got []byte
+	err := client.paginate(context.Background(), server.URL+"/repos", func(body []byte) error {
+		got = append(got, body...)
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("paginate() error: %v", err)
+	}
+
+	if len(requestedPerPage) != len(pages) {
+		t.Fatalf("requested %d pages, want %d", len(requestedPerPage), len(pages))
+	}
+	for _, pp := range requestedPerPage {
+		if pp != "100" {
+			t.Errorf("per_page = %q, want %q", pp, "100")
+		}
+	}
+}
+
+func TestClientDoRetriesTransient5xx(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"login":"acme"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("")
+
+	var org struct {
+		Login string `json:"login"`
+	}
+	if err := client.getJSON(context.Background(), server.URL, &org); err != nil {
+		t.Fatalf("getJSON() error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2 (one failure, one success)", attempts)
+	}
+	if org.Login != "acme" {
+		t.Errorf("Login = %q, want %q", org.Login, "acme")
+	}
+}
+
+func TestClientDoHonorsRetryAfter(t *testing.T) {
+	attempts := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"login":"acme"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient("")
+
+	var org struct {
+		Login string `json:"login"`
+	}
+	if err := client.getJSON(context.Background(), server.URL, &org); err != nil {
+		t.Fatalf("getJSON() error: %v", err)
+	}
+	if attempts != 2 {
+		t.Errorf("attempts = %d, want 2 (one rate-limited, one success)", attempts)
+	}
+}
+
+func TestClientDoAbortsOnContextCancellation(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	client := NewClient("")
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	var out interface{}
+	req, err := client.newRequest(ctx, server.URL)
+	if err != nil {
+		t.Fatalf("newRequest() error: %v", err)
+	}
+	if _, err := client.do(req); err == nil {
+		t.Fatal("do() = nil error on a canceled context, want an error")
+	}
+	_ = out
+}
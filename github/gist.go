@@ -0,0 +1,49 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	ghrecon "github.com/m1dugh/gh-recon"
+)
+
+// Gist is a GitHub gist, as returned by the /users/:user/gists endpoint.
+type Gist struct {
+	Id          string       `json:"id"`
+	Description string       `json:"description"`
+	Public      bool         `json:"public"`
+	PullUrl     string       `json:"git_pull_url"`
+	Owner       ghrecon.User `json:"owner"`
+}
+
+// ToRepository adapts a Gist into a *ghrecon.Repository so it can flow
+// through the same RepoCache and recon pipeline as ordinary repositories.
+func (g *Gist) ToRepository() *ghrecon.Repository {
+	return &ghrecon.Repository{
+		Owner:    g.Owner,
+		Name:     g.Id,
+		FullName: fmt.Sprintf("%s/%s", g.Owner.Login, g.Id),
+		Url:      g.PullUrl,
+		Private:  !g.Public,
+	}
+}
+
+// GetGists returns every gist reachable from rawURL, following pagination.
+func (c *Client) GetGists(ctx context.Context, rawURL string) ([]Gist, error) {
+	var gists []Gist
+
+	err := c.paginate(ctx, extractURL(rawURL), func(body []byte) error {
+		var page []Gist
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("could not parse json page of gists: %w", err)
+		}
+		gists = append(gists, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get gists at %s: %w", rawURL, err)
+	}
+
+	return gists, nil
+}
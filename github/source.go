@@ -0,0 +1,117 @@
+// Package github implements ghrecon.Source against the GitHub REST API.
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	ghrecon "github.com/m1dugh/gh-recon"
+)
+
+const apiBase = "https://api.github.com"
+
+// Source is a ghrecon.Source backed by the GitHub REST API.
+type Source struct {
+	client *Client
+}
+
+// NewSource returns a Source authenticated with the given personal access
+// token or GitHub App installation token.
+func NewSource(token string) *Source {
+	return &Source{client: NewClient(token)}
+}
+
+// Organization fetches the organization named name.
+func (s *Source) Organization(ctx context.Context, name string) (*ghrecon.Organization, error) {
+	return s.getOrganization(ctx, fmt.Sprintf("%s/orgs/%s", apiBase, name))
+}
+
+// Repositories returns every repository owned by owner, which may be an
+// organization login or a user login - GitHub exposes these under
+// different endpoints, so the org endpoint is tried first and the call
+// falls back to the user endpoint on failure.
+func (s *Source) Repositories(ctx context.Context, owner string) ([]ghrecon.Repository, error) {
+	repos, err := s.getRepositories(ctx, fmt.Sprintf("%s/orgs/%s/repos", apiBase, owner))
+	if err == nil {
+		return repos, nil
+	}
+
+	return s.getRepositories(ctx, fmt.Sprintf("%s/users/%s/repos", apiBase, owner))
+}
+
+// Members returns every member of the organization named org.
+func (s *Source) Members(ctx context.Context, org string) ([]ghrecon.User, error) {
+	organization, err := s.Organization(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var users []ghrecon.User
+	err = s.client.paginate(ctx, extractURL(organization.MembersUrl), func(body []byte) error {
+		var page []ghrecon.User
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("could not parse json page of members: %w", err)
+		}
+		users = append(users, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get members for %s: %w", org, err)
+	}
+
+	return users, nil
+}
+
+// Gists returns every gist owned by the user login, converted into
+// ghrecon.Repository so it flows through the common recon pipeline. Gists
+// have no equivalent in the Source interface, so FullRecon type-asserts
+// for ghrecon.GistSource to pick this up.
+func (s *Source) Gists(ctx context.Context, login string) ([]ghrecon.Repository, error) {
+	gists, err := s.client.GetGists(ctx, fmt.Sprintf("%s/users/%s/gists", apiBase, login))
+	if err != nil {
+		return nil, err
+	}
+
+	repos := make([]ghrecon.Repository, len(gists))
+	for i, gist := range gists {
+		repos[i] = *gist.ToRepository()
+	}
+	return repos, nil
+}
+
+func (s *Source) getOrganization(ctx context.Context, rawURL string) (*ghrecon.Organization, error) {
+	var org ghrecon.Organization
+	if err := s.client.getJSON(ctx, rawURL, &org); err != nil {
+		return nil, fmt.Errorf("could not get organization at %s: %w", rawURL, err)
+	}
+
+	return &org, nil
+}
+
+func (s *Source) getRepositories(ctx context.Context, rawURL string) ([]ghrecon.Repository, error) {
+	var repos []ghrecon.Repository
+
+	err := s.client.paginate(ctx, extractURL(rawURL), func(body []byte) error {
+		var page []ghrecon.Repository
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("could not parse json page of repos: %w", err)
+		}
+		repos = append(repos, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return repos, nil
+}
+
+var urlPattern = regexp.MustCompile(`^https?://(\w+\.)+[a-z]{2,5}(/[^"'\s><\\\{\}]+)*`)
+
+// extractURL strips any {/gist_id}-style URI template placeholder GitHub
+// appends to *_url fields (repos_url, members_url, gists_url, ...).
+func extractURL(rawURL string) string {
+	return urlPattern.FindString(rawURL)
+}
@@ -0,0 +1,101 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	ghrecon "github.com/m1dugh/gh-recon"
+)
+
+// roundTripFunc lets a plain function satisfy http.RoundTripper.
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) { return f(req) }
+
+// redirectTo rewires every request to target's host, so tests can exercise
+// code paths (like extractURL) that expect a real-looking hostname (e.g.
+// "api.github.com") while actually talking to an httptest server.
+func redirectTo(target *httptest.Server) http.RoundTripper {
+	base, err := url.Parse(target.URL)
+	if err != nil {
+		panic(err)
+	}
+	return roundTripFunc(func(req *http.Request) (*http.Response, error) {
+		req = req.Clone(req.Context())
+		req.URL.Scheme = base.Scheme
+		req.URL.Host = base.Host
+		return http.DefaultTransport.RoundTrip(req)
+	})
+}
+
+func TestExtractURL(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain url", "https://api.github.com/orgs/acme/members", "https://api.github.com/orgs/acme/members"},
+		{"strips uri template placeholder", "https://api.github.com/orgs/acme/members{/member}", "https://api.github.com/orgs/acme/members"},
+		{"strips gists template placeholder", "https://api.github.com/users/jdoe/gists{/gist_id}", "https://api.github.com/users/jdoe/gists"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := extractURL(tt.in); got != tt.want {
+				t.Errorf("extractURL(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSourceGetOrganization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"login":"acme","members_url":"https://api.github.com/orgs/acme/members{/member}"}`)
+	}))
+	defer server.Close()
+
+	source := NewSource("")
+	org, err := source.getOrganization(context.Background(), server.URL)
+	if err != nil {
+		t.Fatalf("getOrganization() error: %v", err)
+	}
+	if org.Login != "acme" || org.Id != 1 {
+		t.Errorf("getOrganization() = %+v, want Login=acme Id=1", org)
+	}
+}
+
+func TestSourceGetRepositories(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":2,"full_name":"acme/infra","clone_url":"https://github.com/acme/infra.git","private":true,"owner":{"login":"acme","type":"Organization"}}]`)
+	}))
+	defer server.Close()
+
+	source := NewSource("")
+	source.client.httpClient.Transport = redirectTo(server)
+
+	repos, err := source.getRepositories(context.Background(), apiBase+"/orgs/acme/repos")
+	if err != nil {
+		t.Fatalf("getRepositories() error: %v", err)
+	}
+	if len(repos) != 1 || repos[0].FullName != "acme/infra" || !repos[0].Private {
+		t.Fatalf("getRepositories() = %+v, want one private acme/infra repo", repos)
+	}
+}
+
+func TestGistToRepository(t *testing.T) {
+	gist := Gist{
+		Id:      "abc123",
+		Public:  false,
+		PullUrl: "https://gist.github.com/jdoe/abc123.git",
+		Owner:   ghrecon.User{Login: "jdoe"},
+	}
+
+	repo := gist.ToRepository()
+	if repo.FullName != "jdoe/abc123" || repo.Url != gist.PullUrl || !repo.Private {
+		t.Errorf("ToRepository() = %+v, want FullName=jdoe/abc123 Private=true", repo)
+	}
+}
@@ -0,0 +1,130 @@
+// Package gitlab implements ghrecon.Source against the GitLab REST v4 API.
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client is a minimal GitLab REST v4 API client authenticated via a
+// PRIVATE-TOKEN header.
+type Client struct {
+	httpClient *http.Client
+	baseURL    string
+	token      string
+}
+
+// NewClient returns a Client against baseURL (e.g. "https://gitlab.com"),
+// authenticated with a personal or project access token.
+func NewClient(baseURL, token string) *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		token:      token,
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, path string, query url.Values) (*http.Request, error) {
+	rawURL := c.baseURL + "/api/v4" + path
+	if len(query) > 0 {
+		rawURL += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("could not build request for %s: %w", rawURL, err)
+	}
+	if c.token != "" {
+		req.Header.Set("PRIVATE-TOKEN", c.token)
+	}
+
+	return req, nil
+}
+
+func (c *Client) getJSON(ctx context.Context, path string, out interface{}) error {
+	req, err := c.newRequest(ctx, path, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("could not fetch %s: %w", path, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %s for %s", resp.Status, path)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("could not read response from %s: %w", path, err)
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("could not parse json from %s: %w", path, err)
+	}
+
+	return nil
+}
+
+// paginate walks every page of path using GitLab's page/per_page query
+// params, handing each page's raw body to decodePage until the
+// X-Next-Page response header comes back empty.
+func (c *Client) paginate(ctx context.Context, path string, query url.Values, decodePage func(body []byte) error) error {
+	if query == nil {
+		query = url.Values{}
+	}
+	if query.Get("per_page") == "" {
+		query.Set("per_page", "100")
+	}
+
+	page := 1
+	for {
+		query.Set("page", strconv.Itoa(page))
+
+		req, err := c.newRequest(ctx, path, query)
+		if err != nil {
+			return err
+		}
+
+		resp, err := c.httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("could not fetch %s: %w", path, err)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		next := resp.Header.Get("X-Next-Page")
+		status := resp.StatusCode
+		resp.Body.Close()
+
+		if status != http.StatusOK {
+			return fmt.Errorf("unexpected status %d for %s", status, path)
+		}
+		if err != nil {
+			return fmt.Errorf("could not read response from %s: %w", path, err)
+		}
+
+		if err := decodePage(body); err != nil {
+			return err
+		}
+
+		if next == "" {
+			return nil
+		}
+
+		nextPage, err := strconv.Atoi(next)
+		if err != nil {
+			return nil
+		}
+		page = nextPage
+	}
+}
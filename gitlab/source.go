@@ -0,0 +1,186 @@
+package gitlab
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	ghrecon "github.com/m1dugh/gh-recon"
+)
+
+type group struct {
+	Id       int    `json:"id"`
+	Path     string `json:"path"`
+	FullPath string `json:"full_path"`
+}
+
+type project struct {
+	Id                int    `json:"id"`
+	Name              string `json:"name"`
+	PathWithNamespace string `json:"path_with_namespace"`
+	HttpUrlToRepo     string `json:"http_url_to_repo"`
+	Visibility        string `json:"visibility"`
+	Archived          bool   `json:"archived"`
+	ForkedFromProject *struct {
+		Id int `json:"id"`
+	} `json:"forked_from_project"`
+	Namespace struct {
+		Kind string `json:"kind"`
+		Path string `json:"path"`
+	} `json:"namespace"`
+}
+
+type member struct {
+	Id       int    `json:"id"`
+	Username string `json:"username"`
+}
+
+// Source is a ghrecon.Source backed by the GitLab REST v4 API.
+type Source struct {
+	client *Client
+}
+
+// NewSource returns a Source against baseURL (e.g. "https://gitlab.com"),
+// authenticated with a personal or project access token.
+func NewSource(baseURL, token string) *Source {
+	return &Source{client: NewClient(baseURL, token)}
+}
+
+// Organization resolves the GitLab group named name.
+func (s *Source) Organization(ctx context.Context, name string) (*ghrecon.Organization, error) {
+	g, err := s.group(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ghrecon.Organization{Id: g.Id, Login: g.FullPath}, nil
+}
+
+// Repositories returns every project owned by owner. owner is first tried
+// as a group (including its subgroups' projects), then falls back to a
+// user's personal projects.
+func (s *Source) Repositories(ctx context.Context, owner string) ([]ghrecon.Repository, error) {
+	if g, err := s.group(ctx, owner); err == nil {
+		return s.groupProjects(ctx, g)
+	}
+
+	return s.userProjects(ctx, owner)
+}
+
+// Members returns every member of the group named org, including members
+// inherited from ancestor groups.
+func (s *Source) Members(ctx context.Context, org string) ([]ghrecon.User, error) {
+	g, err := s.group(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+
+	var members []member
+	err = s.client.paginate(ctx, fmt.Sprintf("/groups/%d/members/all", g.Id), nil, func(body []byte) error {
+		var page []member
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("could not parse json page of members: %w", err)
+		}
+		members = append(members, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get members of %s: %w", org, err)
+	}
+
+	users := make([]ghrecon.User, len(members))
+	for i, m := range members {
+		users[i] = ghrecon.User{Id: m.Id, Login: m.Username, Type: "User"}
+	}
+	return users, nil
+}
+
+func (s *Source) group(ctx context.Context, path string) (*group, error) {
+	var g group
+	if err := s.client.getJSON(ctx, fmt.Sprintf("/groups/%s", url.PathEscape(path)), &g); err != nil {
+		return nil, fmt.Errorf("could not get group %s: %w", path, err)
+	}
+	return &g, nil
+}
+
+func (s *Source) groupProjects(ctx context.Context, g *group) ([]ghrecon.Repository, error) {
+	var projects []project
+	query := url.Values{"include_subgroups": {"true"}}
+
+	err := s.client.paginate(ctx, fmt.Sprintf("/groups/%d/projects", g.Id), query, func(body []byte) error {
+		var page []project
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("could not parse json page of projects: %w", err)
+		}
+		projects = append(projects, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get projects of group %s: %w", g.FullPath, err)
+	}
+
+	return toRepositories(projects), nil
+}
+
+func (s *Source) userProjects(ctx context.Context, username string) ([]ghrecon.Repository, error) {
+	userID, err := s.resolveUserID(ctx, username)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve gitlab user %s: %w", username, err)
+	}
+
+	var projects []project
+	err = s.client.paginate(ctx, fmt.Sprintf("/users/%d/projects", userID), nil, func(body []byte) error {
+		var page []project
+		if err := json.Unmarshal(body, &page); err != nil {
+			return fmt.Errorf("could not parse json page of projects: %w", err)
+		}
+		projects = append(projects, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not get projects of user %s: %w", username, err)
+	}
+
+	return toRepositories(projects), nil
+}
+
+func (s *Source) resolveUserID(ctx context.Context, username string) (int, error) {
+	var users []struct {
+		Id int `json:"id"`
+	}
+	if err := s.client.getJSON(ctx, fmt.Sprintf("/users?username=%s", url.QueryEscape(username)), &users); err != nil {
+		return 0, err
+	}
+	if len(users) == 0 {
+		return 0, fmt.Errorf("no gitlab user named %s", username)
+	}
+	return users[0].Id, nil
+}
+
+func toRepositories(projects []project) []ghrecon.Repository {
+	repos := make([]ghrecon.Repository, len(projects))
+	for i, p := range projects {
+		repos[i] = ghrecon.Repository{
+			Id:       p.Id,
+			Name:     p.Name,
+			FullName: p.PathWithNamespace,
+			Url:      p.HttpUrlToRepo,
+			Private:  p.Visibility != "public",
+			Archived: p.Archived,
+			Fork:     p.ForkedFromProject != nil,
+			Owner: ghrecon.User{
+				Login: p.Namespace.Path,
+				Type:  namespaceType(p.Namespace.Kind),
+			},
+		}
+	}
+	return repos
+}
+
+func namespaceType(kind string) string {
+	if kind == "group" {
+		return "Organization"
+	}
+	return "User"
+}
@@ -0,0 +1,107 @@
+package gitlab
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func newTestServer(t *testing.T) (*httptest.Server, *http.ServeMux) {
+	t.Helper()
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server, mux
+}
+
+func TestSourceOrganizationResolvesGroup(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v4/groups/acme", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"path":"acme","full_path":"acme"}`)
+	})
+
+	source := NewSource(server.URL, "")
+	org, err := source.Organization(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Organization() error: %v", err)
+	}
+	if org.Login != "acme" || org.Id != 1 {
+		t.Errorf("Organization() = %+v, want Login=acme Id=1", org)
+	}
+}
+
+func TestSourceRepositoriesIncludesSubgroups(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v4/groups/acme", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"path":"acme","full_path":"acme"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/projects", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("include_subgroups") != "true" {
+			t.Errorf("missing include_subgroups=true, got query %q", r.URL.RawQuery)
+		}
+		fmt.Fprint(w, `[{"id":10,"name":"infra","path_with_namespace":"acme/team/infra","http_url_to_repo":"https://gitlab.example.com/acme/team/infra.git","visibility":"private","archived":false,"namespace":{"kind":"group","path":"team"}}]`)
+	})
+
+	source := NewSource(server.URL, "")
+	repos, err := source.Repositories(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Repositories() error: %v", err)
+	}
+	if len(repos) != 1 {
+		t.Fatalf("len(Repositories()) = %d, want 1", len(repos))
+	}
+	repo := repos[0]
+	if repo.FullName != "acme/team/infra" || !repo.Private || repo.Owner.Type != "Organization" {
+		t.Errorf("Repositories()[0] = %+v, want FullName=acme/team/infra Private=true Owner.Type=Organization", repo)
+	}
+}
+
+func TestSourceRepositoriesFallsBackToUserProjects(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v4/groups/jdoe", func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "not found", http.StatusNotFound)
+	})
+	mux.HandleFunc("/api/v4/users", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":42}]`)
+	})
+	mux.HandleFunc("/api/v4/users/42/projects", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":11,"name":"dotfiles","path_with_namespace":"jdoe/dotfiles","http_url_to_repo":"https://gitlab.example.com/jdoe/dotfiles.git","visibility":"public","namespace":{"kind":"user","path":"jdoe"}}]`)
+	})
+
+	source := NewSource(server.URL, "")
+	repos, err := source.Repositories(context.Background(), "jdoe")
+	if err != nil {
+		t.Fatalf("Repositories() error: %v", err)
+	}
+	if len(repos) != 1 || repos[0].FullName != "jdoe/dotfiles" || repos[0].Private {
+		t.Fatalf("Repositories() = %+v, want one public jdoe/dotfiles repo", repos)
+	}
+	if repos[0].Owner.Type != "User" {
+		t.Errorf("Owner.Type = %q, want User", repos[0].Owner.Type)
+	}
+}
+
+func TestSourceMembersIncludesInherited(t *testing.T) {
+	server, mux := newTestServer(t)
+
+	mux.HandleFunc("/api/v4/groups/acme", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"id":1,"path":"acme","full_path":"acme"}`)
+	})
+	mux.HandleFunc("/api/v4/groups/1/members/all", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `[{"id":5,"username":"alice"},{"id":6,"username":"bob"}]`)
+	})
+
+	source := NewSource(server.URL, "")
+	members, err := source.Members(context.Background(), "acme")
+	if err != nil {
+		t.Fatalf("Members() error: %v", err)
+	}
+	if len(members) != 2 || members[0].Login != "alice" || members[1].Login != "bob" {
+		t.Fatalf("Members() = %+v, want alice and bob", members)
+	}
+}
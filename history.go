@@ -0,0 +1,214 @@
+package ghrecon
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"time"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/filemode"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/plumbing/storer"
+	"github.com/go-git/go-git/v5/storage/memory"
+)
+
+// ParseOptions scopes how much of a repository's history ParseRepository
+// walks. The zero value walks every commit on every ref.
+type ParseOptions struct {
+	// SinceCommit restricts the walk to commits authored at or after this
+	// time. Zero means no lower bound.
+	SinceCommit time.Time
+	// MaxCommits caps how many commits are visited. Zero means unbounded.
+	MaxCommits int
+	// IncludeBinary controls whether files go-git detects as binary are
+	// emitted. Secrets are rarely found in binaries, so this defaults to
+	// false.
+	IncludeBinary bool
+	// HeadOnly walks only the current HEAD tree instead of full history,
+	// matching gh-recon's original behavior.
+	HeadOnly bool
+}
+
+// ParseRepository clones repo.Url into a fresh in-memory filesystem and
+// walks its commit history, emitting one TargetFile per (commit, path,
+// blob) tuple so downstream detectors see every historical version of
+// every file - where secrets that were later deleted or rotated typically
+// still live. Each call gets its own filesystem and storage so concurrent
+// callers never share mutable checkout state, and the clone is released
+// for GC as soon as ParseRepository returns. It aborts as soon as ctx is
+// canceled.
+func ParseRepository(ctx context.Context, repo *Repository, opts ParseOptions) ([]TargetFile, error) {
+	memStorage := memory.NewStorage()
+
+	gitRepo, err := git.CloneContext(ctx, memStorage, memfs.New(), &git.CloneOptions{
+		URL: repo.Url,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not clone %s: %w", repo.FullName, err)
+	}
+
+	if opts.HeadOnly {
+		return parseHead(gitRepo, repo, opts.IncludeBinary)
+	}
+
+	logOptions := &git.LogOptions{All: true}
+	if !opts.SinceCommit.IsZero() {
+		logOptions.Since = &opts.SinceCommit
+	}
+
+	commitIter, err := gitRepo.Log(logOptions)
+	if err != nil {
+		return nil, fmt.Errorf("could not walk history of %s: %w", repo.FullName, err)
+	}
+
+	var targets []TargetFile
+	visited := 0
+
+	err = commitIter.ForEach(func(commit *object.Commit) error {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if opts.MaxCommits > 0 && visited >= opts.MaxCommits {
+			return storer.ErrStop
+		}
+		visited++
+
+		files, err := filesChangedIn(commit, repo, opts.IncludeBinary)
+		if err != nil {
+			return fmt.Errorf("could not diff commit %s: %w", commit.Hash, err)
+		}
+
+		targets = append(targets, files...)
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("could not walk history of %s: %w", repo.FullName, err)
+	}
+
+	return targets, nil
+}
+
+func parseHead(gitRepo *git.Repository, repo *Repository, includeBinary bool) ([]TargetFile, error) {
+	head, err := gitRepo.Head()
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve HEAD for %s: %w", repo.FullName, err)
+	}
+
+	commit, err := gitRepo.CommitObject(head.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("could not get HEAD commit for %s: %w", repo.FullName, err)
+	}
+
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get HEAD tree for %s: %w", repo.FullName, err)
+	}
+
+	return filesFromTree(commit, tree, repo, includeBinary)
+}
+
+// filesChangedIn returns a TargetFile for every file added or modified by
+// commit, diffed against each of its parents. Root commits (no parents)
+// emit their entire tree as a baseline.
+func filesChangedIn(commit *object.Commit, repo *Repository, includeBinary bool) ([]TargetFile, error) {
+	tree, err := commit.Tree()
+	if err != nil {
+		return nil, fmt.Errorf("could not get tree for commit %s: %w", commit.Hash, err)
+	}
+
+	if commit.NumParents() == 0 {
+		return filesFromTree(commit, tree, repo, includeBinary)
+	}
+
+	var targets []TargetFile
+
+	err = commit.Parents().ForEach(func(parent *object.Commit) error {
+		parentTree, err := parent.Tree()
+		if err != nil {
+			return err
+		}
+
+		changes, err := parentTree.Diff(tree)
+		if err != nil {
+			return err
+		}
+
+		for _, change := range changes {
+			to := change.To
+			if to.Name == "" {
+				continue // deletion, nothing new to scan
+			}
+
+			target, err := targetFileAt(commit, tree, repo, to.Name, includeBinary)
+			if err != nil {
+				continue
+			}
+			targets = append(targets, target)
+		}
+
+		return nil
+	})
+
+	return targets, err
+}
+
+// filesFromTree emits every regular file in tree as a TargetFile, used for
+// root commits where there is no parent to diff against.
+func filesFromTree(commit *object.Commit, tree *object.Tree, repo *Repository, includeBinary bool) ([]TargetFile, error) {
+	walker := object.NewTreeWalker(tree, true, nil)
+	defer walker.Close()
+
+	var targets []TargetFile
+	for {
+		name, entry, err := walker.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if entry.Mode != filemode.Regular && entry.Mode != filemode.Executable {
+			continue
+		}
+
+		target, err := targetFileAt(commit, tree, repo, name, includeBinary)
+		if err != nil {
+			continue
+		}
+		targets = append(targets, target)
+	}
+
+	return targets, nil
+}
+
+func targetFileAt(commit *object.Commit, tree *object.Tree, repo *Repository, path string, includeBinary bool) (TargetFile, error) {
+	file, err := tree.File(path)
+	if err != nil {
+		return TargetFile{}, err
+	}
+
+	if !includeBinary {
+		if binary, err := file.IsBinary(); err == nil && binary {
+			return TargetFile{}, fmt.Errorf("%s is binary", path)
+		}
+	}
+
+	content, err := file.Contents()
+	if err != nil {
+		return TargetFile{}, err
+	}
+
+	return TargetFile{
+		Filename:     filepath.Base(path),
+		Path:         path,
+		Data:         []byte(content),
+		Repo:         repo,
+		CommitHash:   commit.Hash.String(),
+		CommitAuthor: commit.Author.Email,
+		CommitTime:   commit.Author.When,
+	}, nil
+}
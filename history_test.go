@@ -0,0 +1,128 @@
+package ghrecon
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"testing"
+)
+
+// newHistoryFixtureRepo builds a local git repository with three commits -
+// an initial file, a modification, and a deletion - and returns its path
+// for use as a file:// clone target.
+func newHistoryFixtureRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	write := func(name, content string) {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatalf("could not write %s: %v", name, err)
+		}
+	}
+
+	run("init", "-b", "main")
+
+	write("a.txt", "first version\n")
+	run("add", "a.txt")
+	run("commit", "-m", "add a.txt")
+
+	write("a.txt", "second version\n")
+	write("b.txt", "side file\n")
+	run("add", "a.txt", "b.txt")
+	run("commit", "-m", "modify a.txt, add b.txt")
+
+	run("rm", "b.txt")
+	run("commit", "-m", "remove b.txt")
+
+	return dir
+}
+
+func paths(files []TargetFile) []string {
+	var got []string
+	for _, f := range files {
+		got = append(got, f.Path)
+	}
+	sort.Strings(got)
+	return got
+}
+
+func TestParseRepositoryWalksFullHistory(t *testing.T) {
+	dir := newHistoryFixtureRepo(t)
+	repo := &Repository{FullName: "acme/fixture", Url: "file://" + dir}
+
+	files, err := ParseRepository(context.Background(), repo, ParseOptions{})
+	if err != nil {
+		t.Fatalf("ParseRepository() error: %v", err)
+	}
+
+	// Commit 1 adds a.txt, commit 2 changes a.txt and adds b.txt, commit 3
+	// only removes b.txt (no new blob to emit) - three TargetFiles total.
+	if len(files) != 3 {
+		t.Fatalf("len(files) = %d, want 3; got paths %v", len(files), paths(files))
+	}
+
+	for _, f := range files {
+		if f.Repo != repo {
+			t.Errorf("file %s: Repo = %v, want %v", f.Path, f.Repo, repo)
+		}
+		if f.CommitHash == "" {
+			t.Errorf("file %s: CommitHash is empty", f.Path)
+		}
+	}
+
+	var aVersions []string
+	for _, f := range files {
+		if f.Path == "a.txt" {
+			aVersions = append(aVersions, string(f.Data))
+		}
+	}
+	if len(aVersions) != 2 {
+		t.Fatalf("a.txt versions = %v, want 2 distinct historical blobs", aVersions)
+	}
+}
+
+func TestParseRepositoryHeadOnlyWalksCurrentTreeOnly(t *testing.T) {
+	dir := newHistoryFixtureRepo(t)
+	repo := &Repository{FullName: "acme/fixture", Url: "file://" + dir}
+
+	files, err := ParseRepository(context.Background(), repo, ParseOptions{HeadOnly: true})
+	if err != nil {
+		t.Fatalf("ParseRepository() error: %v", err)
+	}
+
+	got := paths(files)
+	want := []string{"a.txt"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("paths = %v, want %v (b.txt was deleted before HEAD)", got, want)
+	}
+}
+
+func TestParseRepositoryMaxCommits(t *testing.T) {
+	dir := newHistoryFixtureRepo(t)
+	repo := &Repository{FullName: "acme/fixture", Url: "file://" + dir}
+
+	files, err := ParseRepository(context.Background(), repo, ParseOptions{MaxCommits: 1})
+	if err != nil {
+		t.Fatalf("ParseRepository() error: %v", err)
+	}
+
+	// Only the most recent commit (the b.txt removal) is visited, which
+	// has no new blob of its own to emit.
+	if len(files) != 0 {
+		t.Fatalf("len(files) = %d, want 0 for the single most recent (deletion-only) commit; got %v", len(files), paths(files))
+	}
+}
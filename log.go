@@ -0,0 +1,17 @@
+package ghrecon
+
+import (
+	"log/slog"
+	"os"
+)
+
+var baseLogger = slog.New(slog.NewTextHandler(os.Stderr, nil))
+
+// CreateSubLogger returns a structured logger with args attached to every
+// line it writes, e.g.
+// CreateSubLogger("stage", "clone", "repo", repo.FullName), so call sites
+// can scope their logging to a stage/repo without threading a logger
+// through every function signature.
+func CreateSubLogger(args ...any) *slog.Logger {
+	return baseLogger.With(args...)
+}
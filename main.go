@@ -1,21 +1,13 @@
 package ghrecon
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"io/ioutil"
-	"log"
-	"net/http"
-	"regexp"
-	"sync"
-
-	"github.com/go-git/go-billy/v5"
-	"github.com/go-git/go-billy/v5/memfs"
-	"github.com/go-git/go-git/v5"
-	"github.com/go-git/go-git/v5/plumbing"
-	"github.com/go-git/go-git/v5/plumbing/filemode"
-	"github.com/go-git/go-git/v5/plumbing/object"
-	"github.com/go-git/go-git/v5/storage/memory"
+	"runtime"
+	"time"
+
+	"github.com/m1dugh/gh-recon/detectors"
+	"golang.org/x/sync/errgroup"
 )
 
 type User struct {
@@ -23,6 +15,7 @@ type User struct {
 	Id       int    `json:"id"`
 	Type     string `json:"type"`
 	ReposUrl string `json:"repos_url"`
+	GistsUrl string `json:"gists_url"`
 }
 
 type Repository struct {
@@ -31,180 +24,174 @@ type Repository struct {
 	Name     string `json:"name"`
 	FullName string `json:"full_name"`
 	Url      string `json:"clone_url"`
+	Private  bool   `json:"private"`
+	Fork     bool   `json:"fork"`
+	Archived bool   `json:"archived"`
+}
+
+type Organization struct {
+	Id         int    `json:"id"`
+	Login      string `json:"login"`
+	ReposUrl   string `json:"repos_url"`
+	MembersUrl string `json:"members_url"`
 }
 
 type TargetFile struct {
-	Filename string
-	Data     []byte
-	Repo     *Repository
+	Filename     string
+	Path         string
+	Data         []byte
+	Repo         *Repository
+	CommitHash   string
+	CommitAuthor string
+	CommitTime   time.Time
 }
 
-func _GetRepositories(url string) []Repository {
-	var repos []Repository
-	response, err := http.Get(url)
-	if err != nil {
-		log.Fatal("could not fetch repos")
-	} else if response.StatusCode == 403 {
-		log.Fatal("rate limited by github")
-	}
-	defer response.Body.Close()
+// ReconOptions configures FullRecon. Concurrency bounds how many repos are
+// cloned and scanned at once; zero defaults to runtime.NumCPU().
+type ReconOptions struct {
+	IncludeRepos []string
+	IgnoreRepos  []string
+	Detectors    []detectors.Detector
+	Verify       bool
+	Concurrency  int
+}
 
-	body, _ := ioutil.ReadAll(response.Body)
+// FullRecon walks every repository reachable from the organization named
+// orgName across every given source (its own repos, each member's personal
+// repos, and - for sources implementing GistSource - each member's gists),
+// deduplicating through a RepoCache filtered by opts.IncludeRepos /
+// opts.IgnoreRepos glob patterns, and runs opts.Detectors over every file
+// found. Cloning and scanning are bounded to opts.Concurrency repos at a
+// time; ctx cancels the whole run.
+func FullRecon(ctx context.Context, sources []Source, orgName string, opts ReconOptions) ([]detectors.Finding, error) {
+	logger := CreateSubLogger("stage", "recon", "org", orgName)
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = runtime.NumCPU()
+	}
 
-	err = json.Unmarshal(body, &repos)
-	if err != nil {
-		log.Fatal("could not parse json response for repos")
+	cache := NewRepoCache(opts.IncludeRepos, opts.IgnoreRepos)
+	for _, source := range sources {
+		if err := collectFromSource(ctx, source, orgName, cache); err != nil {
+			logger.Warn("could not collect from source", "error", err)
+		}
 	}
 
-	return repos
-}
+	repos := cache.Values()
+	logger.Info("repositories discovered", "count", len(repos))
 
-func (u *User) GetRepositories() []Repository {
-	return _GetRepositories(_ExtractUrl(u.ReposUrl))
-}
+	// order repositories based on how likely they are to have sensitive informations
 
-func (org *Organization) GetRepositories() []Repository {
-	return _GetRepositories(_ExtractUrl(org.ReposUrl))
-}
+	// clone and scan every repository, bounded to `concurrency` at a time
 
-func ParseRepository(fs *billy.Filesystem, repo *Repository) []TargetFile {
-	storer := memory.NewStorage()
+	group, groupCtx := errgroup.WithContext(ctx)
+	group.SetLimit(concurrency)
 
-	if _, err := git.Clone(storer, *fs, &git.CloneOptions{
-		URL: repo.Url,
-	}); err != nil {
-		log.Fatal(fmt.Sprintf("could not fetch %s", repo.FullName))
-	}
+	files := make(chan TargetFile)
 
-	names := make(map[plumbing.Hash]string)
-	for _, binTree := range storer.Trees {
-		tree, _ := object.DecodeTree(storer, binTree)
+	// Start the detector workers before dispatching any clone, since
+	// group.Go blocks once concurrency slots are full: if nothing is
+	// reading files yet, the first `concurrency` clones block trying to
+	// send while the dispatch loop below blocks waiting for a free slot.
+	findingsCh := RunDetectors(groupCtx, files, opts.Detectors, opts.Verify, concurrency)
 
-		for _, entry := range tree.Entries {
-			if entry.Mode == filemode.Regular {
+	for _, repo := range repos {
+		repo := repo
+		group.Go(func() error {
+			repoLogger := CreateSubLogger("stage", "clone", "repo", repo.FullName)
+
+			repoFiles, err := ParseRepository(groupCtx, repo, ParseOptions{})
+			if err != nil {
+				repoLogger.Warn("could not parse repository", "error", err)
+				return nil
+			}
 
-				names[entry.Hash] = entry.Name
+			for _, file := range repoFiles {
+				select {
+				case files <- file:
+				case <-groupCtx.Done():
+					return groupCtx.Err()
+				}
 			}
-		}
+
+			return nil
+		})
 	}
 
-	targets := make([]TargetFile, len(names))
-	i := 0
-	for _, obj := range storer.Blobs {
+	var groupErr error
+	go func() {
+		groupErr = group.Wait()
+		close(files)
+	}()
 
-		reader, _ := obj.Reader()
-		data, _ := ioutil.ReadAll(reader)
-		targets[i] = TargetFile{
-			names[obj.Hash()],
-			data,
-			repo,
-		}
-		i++
+	var findings []detectors.Finding
+	for finding := range findingsCh {
+		findings = append(findings, finding)
 	}
 
-	return targets
-}
+	if groupErr != nil {
+		return findings, fmt.Errorf("recon of %s did not complete: %w", orgName, groupErr)
+	}
 
-type Organization struct {
-	Id         int    `json:"id"`
-	Login      string `json:"login"`
-	ReposUrl   string `json:"repos_url"`
-	MembersUrl string `json:"members_url"`
-}
+	logger.Info("recon complete", "findings", len(findings))
 
-func _ExtractUrl(url string) string {
-	urlPattern := regexp.MustCompile(`^https?://(\w+\.)+[a-z]{2,5}(/[^"'\s><\\\{\}]+)*`)
-	return urlPattern.FindString(url)
+	return findings, nil
 }
 
-func GetOrganization(url string) *Organization {
-	response, err := http.Get(url)
-
+// collectFromSource fetches orgName's repos, each member's personal repos,
+// and (where supported) each member's gists from source, adding every one
+// to cache.
+func collectFromSource(ctx context.Context, source Source, orgName string, cache *RepoCache) error {
+	org, err := source.Organization(ctx, orgName)
 	if err != nil {
-		log.Fatal(fmt.Sprintf("could not get organization for url %s", url))
-	} else if response.StatusCode == 403 {
-		log.Fatal("could not fetch organization, rate limited by github")
+		return fmt.Errorf("could not get organization %s: %w", orgName, err)
 	}
-	defer response.Body.Close()
-
-	body, _ := ioutil.ReadAll(response.Body)
 
-	var org Organization
-	if json.Unmarshal(body, &org) != nil {
-		log.Fatal("could not parse response as json at get organization")
+	members, err := source.Members(ctx, org.Login)
+	if err != nil {
+		return fmt.Errorf("could not get members of %s: %w", org.Login, err)
 	}
 
-	return &org
-}
-
-func (org *Organization) GetMembers() []User {
-	response, err := http.Get(org.MembersUrl)
-
-	fmt.Println("members url: ", _ExtractUrl(org.MembersUrl))
-
+	orgRepos, err := source.Repositories(ctx, org.Login)
 	if err != nil {
-		log.Fatal(fmt.Sprintf("could not get members for url %s", org.MembersUrl))
-	} else if response.StatusCode == 403 {
-		log.Fatal("rate limited by github")
+		return fmt.Errorf("could not get repositories of %s: %w", org.Login, err)
 	}
-
-	defer response.Body.Close()
-
-	body, _ := ioutil.ReadAll(response.Body)
-
-	var users []User
-	if json.Unmarshal(body, &users) != nil {
-		return make([]User, 0)
+	for i := range orgRepos {
+		cache.Add(&orgRepos[i])
 	}
 
-	return users
-}
-
-func FullRecon(url string, hooks []func(TargetFile)) {
-
-	org := GetOrganization(url)
-
-	// get users in organization.
+	gistSource, _ := source.(GistSource)
+	logger := CreateSubLogger("stage", "collect", "org", org.Login)
 
-	users := org.GetMembers()
-
-	// get all projects
-
-	repos := org.GetRepositories()
-
-	for _, user := range users {
-		var userRepos []Repository = user.GetRepositories()
+	for _, user := range members {
+		userRepos, err := source.Repositories(ctx, user.Login)
+		if err != nil {
+			logger.Warn("could not get repositories for member", "user", user.Login, "error", err)
+			continue
+		}
 
-		for _, repo := range userRepos {
-			if repo.Owner.Type == "Organization" && repo.Owner.Login == org.Login {
+		for i := range userRepos {
+			if userRepos[i].Owner.Type == "Organization" && userRepos[i].Owner.Login == org.Login {
 				continue
 			}
 
-			repos = append(repos, repo)
+			cache.Add(&userRepos[i])
 		}
 
-	}
-
-	// order repositories based on how likely they are to have sensitive informations
-
-	// get all data in all repositories
-
-	fs := memfs.New()
-	wg := &sync.WaitGroup{}
-	input := make(chan *Repository)
-	for _, repo := range repos {
-		wg.Add(1)
-		go (func(fs *billy.Filesystem) {
-			defer wg.Done()
-			for _, file := range ParseRepository(fs, <-input) {
-				for _, f := range hooks {
-					f(file)
-				}
-			}
-		})(&fs)
+		if gistSource == nil {
+			continue
+		}
 
-		input <- &repo
+		gists, err := gistSource.Gists(ctx, user.Login)
+		if err != nil {
+			logger.Warn("could not get gists for member", "user", user.Login, "error", err)
+			continue
+		}
+		for i := range gists {
+			cache.Add(&gists[i])
+		}
 	}
 
-	wg.Wait()
+	return nil
 }
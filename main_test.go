@@ -0,0 +1,112 @@
+package ghrecon
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/m1dugh/gh-recon/detectors"
+)
+
+// newFixtureRepo creates a local git repository with a single commit and
+// one file, usable as a file:// clone target, so tests can exercise
+// ParseRepository/FullRecon without reaching out to a real forge.
+func newFixtureRepo(t *testing.T) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	run := func(args ...string) {
+		cmd := exec.Command("git", args...)
+		cmd.Dir = dir
+		cmd.Env = append(os.Environ(),
+			"GIT_AUTHOR_NAME=test", "GIT_AUTHOR_EMAIL=test@example.com",
+			"GIT_COMMITTER_NAME=test", "GIT_COMMITTER_EMAIL=test@example.com",
+		)
+		if out, err := cmd.CombinedOutput(); err != nil {
+			t.Fatalf("git %v: %v\n%s", args, err, out)
+		}
+	}
+
+	run("init", "-b", "main")
+	if err := os.WriteFile(filepath.Join(dir, "README.md"), []byte("hello world\n"), 0644); err != nil {
+		t.Fatalf("could not write fixture file: %v", err)
+	}
+	run("add", "README.md")
+	run("commit", "-m", "initial commit")
+
+	return dir
+}
+
+// fakeSource is a minimal ghrecon.Source backed by an in-memory list of
+// repositories, used to drive FullRecon without a real forge API.
+type fakeSource struct {
+	org   Organization
+	repos []Repository
+}
+
+func (s *fakeSource) Organization(ctx context.Context, name string) (*Organization, error) {
+	return &s.org, nil
+}
+
+func (s *fakeSource) Repositories(ctx context.Context, owner string) ([]Repository, error) {
+	if owner != s.org.Login {
+		return nil, fmt.Errorf("no such owner %s", owner)
+	}
+	return s.repos, nil
+}
+
+func (s *fakeSource) Members(ctx context.Context, org string) ([]User, error) {
+	return nil, nil
+}
+
+// TestFullReconDoesNotDeadlockWithMoreReposThanConcurrency reproduces the
+// classic circular wait: with more repos than Concurrency, the first
+// Concurrency clone goroutines must be able to flush their files to a
+// consumer that is already running, or they block forever waiting for a
+// reader while the dispatch loop blocks waiting for a free errgroup slot.
+func TestFullReconDoesNotDeadlockWithMoreReposThanConcurrency(t *testing.T) {
+	repoDir := newFixtureRepo(t)
+	repoURL := "file://" + repoDir
+
+	const repoCount = 5
+	const concurrency = 2
+
+	repos := make([]Repository, repoCount)
+	for i := range repos {
+		repos[i] = Repository{
+			FullName: fmt.Sprintf("acme/repo-%d", i),
+			Name:     fmt.Sprintf("repo-%d", i),
+			Url:      repoURL,
+			Owner:    User{Login: "acme", Type: "Organization"},
+		}
+	}
+
+	source := &fakeSource{org: Organization{Login: "acme"}, repos: repos}
+
+	done := make(chan struct{})
+	var findings []detectors.Finding
+	var err error
+
+	go func() {
+		findings, err = FullRecon(context.Background(), []Source{source}, "acme", ReconOptions{
+			Concurrency: concurrency,
+			Detectors:   detectors.Default(),
+		})
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(15 * time.Second):
+		t.Fatal("FullRecon did not return within 15s, likely deadlocked")
+	}
+
+	if err != nil {
+		t.Fatalf("FullRecon() error: %v", err)
+	}
+	_ = findings
+}
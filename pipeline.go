@@ -0,0 +1,87 @@
+package ghrecon
+
+import (
+	"context"
+	"runtime"
+	"strings"
+	"sync"
+
+	"github.com/m1dugh/gh-recon/detectors"
+)
+
+// RunDetectors fans files out across a bounded worker pool, running the
+// keyword prefilter ahead of each detector's full match so cheap
+// substring checks skip the detectors that can't possibly apply, and
+// returns a channel of every Finding produced. The channel closes once
+// files is closed and every worker has drained.
+func RunDetectors(ctx context.Context, files <-chan TargetFile, dets []detectors.Detector, verify bool, workers int) <-chan detectors.Finding {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	findings := make(chan detectors.Finding)
+
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for i := 0; i < workers; i++ {
+		go func() {
+			defer wg.Done()
+			scanFile(ctx, files, dets, verify, findings)
+		}()
+	}
+
+	go func() {
+		wg.Wait()
+		close(findings)
+	}()
+
+	return findings
+}
+
+func scanFile(ctx context.Context, files <-chan TargetFile, dets []detectors.Detector, verify bool, findings chan<- detectors.Finding) {
+	for file := range files {
+		lowered := strings.ToLower(string(file.Data))
+
+		for _, det := range dets {
+			if !keywordsPresent(det.Keywords(), lowered) {
+				continue
+			}
+
+			matches, err := det.FromData(ctx, verify, file.Data)
+			if err != nil {
+				continue
+			}
+
+			for _, finding := range matches {
+				finding.Path = file.Path
+				finding.CommitHash = file.CommitHash
+				if file.Repo != nil {
+					finding.Repo = file.Repo.FullName
+				}
+
+				select {
+				case findings <- finding:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}
+}
+
+// keywordsPresent reports whether any keyword appears in lowered, which
+// must already be lowercased. No keywords means the detector has no cheap
+// prefilter and must always run.
+func keywordsPresent(keywords []string, lowered string) bool {
+	if len(keywords) == 0 {
+		return true
+	}
+
+	for _, keyword := range keywords {
+		if strings.Contains(lowered, strings.ToLower(keyword)) {
+			return true
+		}
+	}
+
+	return false
+}
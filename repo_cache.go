@@ -0,0 +1,104 @@
+package ghrecon
+
+import (
+	"net/url"
+	"path"
+	"strings"
+	"sync"
+)
+
+// RepoCache deduplicates repositories discovered from multiple sources (an
+// org's own listing, each member's personal repos, gists) and applies
+// include/ignore glob rules against the repo's full name, so callers can
+// skip forks, archived repos, or specific names instead of hand-rolling
+// the filtering at every call site.
+type RepoCache struct {
+	includes []string
+	ignores  []string
+
+	mu    sync.Mutex
+	repos map[string]*Repository
+}
+
+// NewRepoCache builds a RepoCache. A repository is kept only if it matches
+// at least one of includes (or includes is empty) and none of ignores.
+// Patterns are matched against the repo's full name ("owner/repo") using
+// path.Match glob syntax.
+func NewRepoCache(includes, ignores []string) *RepoCache {
+	return &RepoCache{
+		includes: includes,
+		ignores:  ignores,
+		repos:    make(map[string]*Repository),
+	}
+}
+
+// Add inserts repo into the cache, returning false if it was already
+// present or filtered out by the include/ignore rules.
+func (rc *RepoCache) Add(repo *Repository) bool {
+	if !rc.allowed(strings.ToLower(repo.FullName)) {
+		return false
+	}
+
+	key := cacheKey(repo)
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	if _, ok := rc.repos[key]; ok {
+		return false
+	}
+
+	rc.repos[key] = repo
+	return true
+}
+
+// Values returns every repository currently held by the cache, in no
+// particular order.
+func (rc *RepoCache) Values() []*Repository {
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	values := make([]*Repository, 0, len(rc.repos))
+	for _, repo := range rc.repos {
+		values = append(values, repo)
+	}
+	return values
+}
+
+func (rc *RepoCache) allowed(name string) bool {
+	for _, ignore := range rc.ignores {
+		if globMatch(ignore, name) {
+			return false
+		}
+	}
+
+	if len(rc.includes) == 0 {
+		return true
+	}
+
+	for _, include := range rc.includes {
+		if globMatch(include, name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func globMatch(pattern, name string) bool {
+	ok, err := path.Match(pattern, name)
+	return err == nil && ok
+}
+
+// cacheKey normalizes a repository's full name, qualified by its clone
+// URL's host, so the same repo surfaced via the org listing and a
+// member's personal repos collapses to one entry while repos that merely
+// share a full name on two different forges (recon'd through separate
+// Sources) stay distinct.
+func cacheKey(repo *Repository) string {
+	host := ""
+	if parsed, err := url.Parse(repo.Url); err == nil {
+		host = parsed.Host
+	}
+	return strings.ToLower(host + "/" + repo.FullName)
+}
@@ -0,0 +1,61 @@
+package ghrecon
+
+import "testing"
+
+func TestRepoCacheAddDeduplicates(t *testing.T) {
+	cache := NewRepoCache(nil, nil)
+	repo := &Repository{FullName: "acme/infra", Url: "https://github.com/acme/infra.git"}
+
+	if !cache.Add(repo) {
+		t.Fatal("Add() = false on first insert, want true")
+	}
+	if cache.Add(repo) {
+		t.Fatal("Add() = true on duplicate insert, want false")
+	}
+	if got := len(cache.Values()); got != 1 {
+		t.Fatalf("len(Values()) = %d, want 1", got)
+	}
+}
+
+func TestRepoCacheAddDistinguishesByHost(t *testing.T) {
+	cache := NewRepoCache(nil, nil)
+	github := &Repository{FullName: "acme/infra", Url: "https://github.com/acme/infra.git"}
+	gitea := &Repository{FullName: "acme/infra", Url: "https://git.example.com/acme/infra.git"}
+
+	if !cache.Add(github) {
+		t.Fatal("Add(github repo) = false, want true")
+	}
+	if !cache.Add(gitea) {
+		t.Fatal("Add(gitea repo with same full name on a different host) = false, want true")
+	}
+	if got := len(cache.Values()); got != 2 {
+		t.Fatalf("len(Values()) = %d, want 2", got)
+	}
+}
+
+func TestRepoCacheAllowedPrecedence(t *testing.T) {
+	tests := []struct {
+		name     string
+		includes []string
+		ignores  []string
+		repo     string
+		want     bool
+	}{
+		{"no rules allows everything", nil, nil, "acme/infra", true},
+		{"include match passes", []string{"acme/*"}, nil, "acme/infra", true},
+		{"include mismatch fails", []string{"other/*"}, nil, "acme/infra", false},
+		{"ignore match fails", nil, []string{"acme/infra"}, "acme/infra", false},
+		{"ignore takes precedence over include", []string{"acme/*"}, []string{"acme/infra"}, "acme/infra", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cache := NewRepoCache(tt.includes, tt.ignores)
+			repo := &Repository{FullName: tt.repo, Url: "https://github.com/" + tt.repo + ".git"}
+
+			if got := cache.Add(repo); got != tt.want {
+				t.Errorf("Add(%q) = %v, want %v", tt.repo, got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package ghrecon
+
+import "context"
+
+// Source is a forge backend capable of resolving an organization's
+// identity and listing its repositories and members. github.Source,
+// gitlab.Source, gitea.Source, and bitbucket.Source each implement it, so
+// FullRecon can recon the same organization name across every configured
+// forge in one run.
+type Source interface {
+	// Organization resolves the organization named name.
+	Organization(ctx context.Context, name string) (*Organization, error)
+
+	// Repositories returns every repository owned by owner, which may be
+	// an organization name or a user login.
+	Repositories(ctx context.Context, owner string) ([]Repository, error)
+
+	// Members returns every member of the organization named org.
+	Members(ctx context.Context, org string) ([]User, error)
+}
+
+// GistSource is implemented by sources that also expose a user's gists,
+// adapted into Repository so they flow through the same recon pipeline.
+// Not every forge has an equivalent concept, so this is optional: FullRecon
+// type-asserts for it rather than requiring it on Source.
+type GistSource interface {
+	Gists(ctx context.Context, login string) ([]Repository, error)
+}